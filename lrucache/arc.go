@@ -0,0 +1,261 @@
+package lrucache
+
+import "sync"
+
+// arcNode is a single entry inside one of the four ARC lists.
+// B1/B2 nodes are ghosts: they only ever carry a key, val stays zero.
+type arcNode[K comparable, V any] struct {
+	prev *arcNode[K, V]
+	next *arcNode[K, V]
+	key  K
+	val  V
+}
+
+// arcList is a tiny doubly linked list with dummy head/tail sentinels,
+// shared by T1, T2, B1 and B2. left.next is the LRU end, right.prev is
+// the MRU end - same convention as the plain LRU cache.
+type arcList[K comparable, V any] struct {
+	left  *arcNode[K, V]
+	right *arcNode[K, V]
+	size  int
+}
+
+func newArcList[K comparable, V any]() *arcList[K, V] {
+	l := &arcList[K, V]{left: &arcNode[K, V]{}, right: &arcNode[K, V]{}}
+	l.right.prev = l.left
+	l.left.next = l.right
+	return l
+}
+
+func (l *arcList[K, V]) pushMRU(n *arcNode[K, V]) {
+	currRight := l.right
+	currPrev := l.right.prev
+
+	currPrev.next = n
+	currRight.prev = n
+
+	n.prev = currPrev
+	n.next = currRight
+	l.size++
+}
+
+func (l *arcList[K, V]) remove(n *arcNode[K, V]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	l.size--
+}
+
+func (l *arcList[K, V]) popLRU() *arcNode[K, V] {
+	n := l.left.next
+	l.remove(n)
+	return n
+}
+
+// ARC → Adaptive Replacement Cache.
+// Adapts between recency (T1) and frequency (T2) by watching which of
+// the two ghost lists (B1, B2) produces hits, and shifting the target
+// size of T1 (the parameter p) towards whichever workload it is seeing.
+// See Megiddo & Modha, "ARC: A Self-Tuning, Low Overhead Replacement Cache".
+type ARC[K comparable, V any] struct {
+	cap int
+	p   int // target size of T1
+
+	t1, t2 *arcList[K, V] // real entries: seen-once, seen-more-than-once
+	b1, b2 *arcList[K, V] // ghost entries: keys evicted from T1/T2, no values
+
+	index map[K]*arcNode[K, V] // key -> node, wherever it currently lives
+	owner map[K]*arcList[K, V] // key -> the list currently holding it
+	my    sync.RWMutex
+}
+
+// ArcStats reports the current size of each ARC list plus the adaptive
+// parameter p, for observability.
+type ArcStats struct {
+	T1, T2, B1, B2 int
+	P              int
+}
+
+// NewARC creates an ARC cache with the given capacity.
+func NewARC[K comparable, V any](cap int) *ARC[K, V] {
+	return &ARC[K, V]{
+		cap:   cap,
+		t1:    newArcList[K, V](),
+		t2:    newArcList[K, V](),
+		b1:    newArcList[K, V](),
+		b2:    newArcList[K, V](),
+		index: make(map[K]*arcNode[K, V]),
+		owner: make(map[K]*arcList[K, V]),
+	}
+}
+
+// Get returns the value for key, promoting a T1 hit to the MRU of T2
+// and refreshing a T2 hit's position. Ghost hits (B1/B2) and misses
+// both report false; only Put adapts p and resurrects a ghost entry.
+func (a *ARC[K, V]) Get(key K) (V, bool) {
+	a.my.Lock()
+	defer a.my.Unlock()
+
+	n, exists := a.index[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	switch a.owner[key] {
+	case a.t1:
+		a.t1.remove(n)
+		a.t2.pushMRU(n)
+		a.owner[key] = a.t2
+		return n.val, true
+	case a.t2:
+		a.t2.remove(n)
+		a.t2.pushMRU(n)
+		return n.val, true
+	default: // B1 or B2 - a ghost has no value to serve
+		var zero V
+		return zero, false
+	}
+}
+
+// Put inserts or updates key. A hit in T1/T2 just updates the value and
+// reorders as Get would. A hit in a ghost list adapts p towards the
+// list that produced the hit and resurrects the key into T2. A brand
+// new key first makes room when the lists are at capacity (dropping a
+// ghost, dropping T1's LRU, or via the replace() step) before landing
+// at the MRU of T1.
+func (a *ARC[K, V]) Put(key K, value V) bool {
+	a.my.Lock()
+	defer a.my.Unlock()
+
+	if n, exists := a.index[key]; exists {
+		switch a.owner[key] {
+		case a.t1:
+			a.t1.remove(n)
+			n.val = value
+			a.t2.pushMRU(n)
+			a.owner[key] = a.t2
+			return true
+		case a.t2:
+			a.t2.remove(n)
+			n.val = value
+			a.t2.pushMRU(n)
+			return true
+		case a.b1:
+			a.adaptUp()
+			a.replace(false)
+			a.b1.remove(n)
+			n.val = value
+			a.t2.pushMRU(n)
+			a.owner[key] = a.t2
+			return true
+		case a.b2:
+			a.adaptDown()
+			a.replace(true)
+			a.b2.remove(n)
+			n.val = value
+			a.t2.pushMRU(n)
+			a.owner[key] = a.t2
+			return true
+		}
+	}
+
+	// Brand new key.
+	switch {
+	case a.t1.size+a.b1.size == a.cap:
+		if a.t1.size < a.cap {
+			a.evictGhost(a.b1)
+			a.replace(false)
+		} else if a.t1.size > 0 {
+			a.evictReal(a.t1)
+		}
+		// cap == 0: t1 and b1 are both empty, so there's nothing eligible
+		// to evict - same as the sibling Policy implementations refusing
+		// to evict rather than popping an empty list.
+	case a.t1.size+a.b1.size < a.cap && a.t1.size+a.t2.size+a.b1.size+a.b2.size >= a.cap:
+		if a.t1.size+a.t2.size+a.b1.size+a.b2.size == 2*a.cap {
+			a.evictGhost(a.b2)
+		}
+		a.replace(false)
+	}
+
+	n := &arcNode[K, V]{key: key, val: value}
+	a.t1.pushMRU(n)
+	a.index[key] = n
+	a.owner[key] = a.t1
+	return true
+}
+
+// adaptUp grows p on a B1 ghost hit: p = min(cap, p + max(1, |B2|/|B1|)).
+func (a *ARC[K, V]) adaptUp() {
+	delta := 1
+	if a.b1.size > 0 && a.b2.size/a.b1.size > delta {
+		delta = a.b2.size / a.b1.size
+	}
+	a.p += delta
+	if a.p > a.cap {
+		a.p = a.cap
+	}
+}
+
+// adaptDown shrinks p on a B2 ghost hit: p = max(0, p - max(1, |B1|/|B2|)).
+func (a *ARC[K, V]) adaptDown() {
+	delta := 1
+	if a.b2.size > 0 && a.b1.size/a.b2.size > delta {
+		delta = a.b1.size / a.b2.size
+	}
+	a.p -= delta
+	if a.p < 0 {
+		a.p = 0
+	}
+}
+
+// replace evicts the LRU of T1 or T2 into the matching ghost list,
+// favouring T1 unless it has shrunk to (or below, on a B2 hit) p.
+func (a *ARC[K, V]) replace(b2Hit bool) {
+	if a.t1.size >= 1 && (a.t1.size > a.p || (b2Hit && a.t1.size == a.p)) {
+		n := a.t1.popLRU()
+		delete(a.index, n.key)
+		var zero V
+		n.val = zero
+		a.b1.pushMRU(n)
+		a.index[n.key] = n
+		a.owner[n.key] = a.b1
+		return
+	}
+
+	n := a.t2.popLRU()
+	delete(a.index, n.key)
+	var zero V
+	n.val = zero
+	a.b2.pushMRU(n)
+	a.index[n.key] = n
+	a.owner[n.key] = a.b2
+}
+
+// evictGhost drops the LRU entry of a ghost list entirely.
+func (a *ARC[K, V]) evictGhost(list *arcList[K, V]) {
+	n := list.popLRU()
+	delete(a.index, n.key)
+	delete(a.owner, n.key)
+}
+
+// evictReal drops the LRU entry of a real list (T1) with no ghost kept.
+func (a *ARC[K, V]) evictReal(list *arcList[K, V]) {
+	n := list.popLRU()
+	delete(a.index, n.key)
+	delete(a.owner, n.key)
+}
+
+// Stats reports the current sizes of T1/T2/B1/B2 and p.
+func (a *ARC[K, V]) Stats() ArcStats {
+	a.my.RLock()
+	defer a.my.RUnlock()
+
+	return ArcStats{
+		T1: a.t1.size,
+		T2: a.t2.size,
+		B1: a.b1.size,
+		B2: a.b2.size,
+		P:  a.p,
+	}
+}
@@ -0,0 +1,51 @@
+package lrucache
+
+import "testing"
+
+func TestARCPromotesT1HitToT2(t *testing.T) {
+	arc := NewARC[int, int](2)
+
+	arc.Put(1, 100)
+	arc.Put(2, 200)
+
+	if v, ok := arc.Get(1); !ok || v != 100 {
+		t.Fatalf("Get(1) = %v, %v; want 100, true", v, ok)
+	}
+
+	stats := arc.Stats()
+	if stats.T1 != 1 || stats.T2 != 1 {
+		t.Fatalf("Stats() = %+v; want T1=1, T2=1 after promoting key 1", stats)
+	}
+}
+
+func TestARCGhostHitAdaptsP(t *testing.T) {
+	arc := NewARC[int, int](2)
+
+	arc.Put(1, 100)
+	arc.Get(1)        // promote 1 into T2 so T1 isn't the only list holding entries
+	arc.Put(2, 200)   // T1={2}, T2={1}
+	arc.Put(3, 300)   // over capacity with T2 non-empty: replace() moves 2 from T1 into B1
+
+	if _, ok := arc.Get(2); ok {
+		t.Fatalf("Get(2) should miss once evicted to the ghost list")
+	}
+
+	before := arc.Stats().P
+	arc.Put(2, 999) // ghost hit in B1 should grow p and resurrect 2 into T2
+	after := arc.Stats()
+
+	if after.P <= before {
+		t.Fatalf("Stats().P = %d; want > %d after a B1 ghost hit", after.P, before)
+	}
+	if v, ok := arc.Get(2); !ok || v != 999 {
+		t.Fatalf("Get(2) = %v, %v; want 999, true after resurrection", v, ok)
+	}
+}
+
+func TestARCMissOnUnknownKey(t *testing.T) {
+	arc := NewARC[string, string](2)
+
+	if _, ok := arc.Get("missing"); ok {
+		t.Fatalf("Get(\"missing\") should report a miss")
+	}
+}
@@ -1,88 +1,238 @@
 package lrucache
 
 import (
+	"container/heap"
 	"fmt"
 	"sync"
+	"time"
 )
 
-type Node struct {
-	prev *Node
-	next *Node
-	val  int
-	key  int
+// Node is a single cache entry in the doubly linked list, carrying
+// whatever metadata the active Policy (and TTL) need alongside it.
+type Node[K comparable, V any] struct {
+	prev *Node[K, V]
+	next *Node[K, V]
+	val  V
+	key  K
+
+	freq      int       // access count, used by the LFU policy
+	expireAt  time.Time // zero value means "no TTL"
+	heapIndex int       // position in the expiry min-heap, -1 when not tracked
 }
 
-type LRU struct {
-	right *Node
-	left  *Node
+// Cache is a generic, thread-safe map+DLL cache with a pluggable
+// eviction Policy and TTL support.
+type Cache[K comparable, V any] struct {
+	right *Node[K, V]
+	left  *Node[K, V]
 	cap   int
-	cache map[int]*Node
+	cache map[K]*Node[K, V]
 	my    sync.RWMutex
+
+	policyType PolicyType
+	policy     Policy[K, V]
+
+	expiry      expiryHeap[K, V]
+	janitorDone chan struct{}
+	janitorOnce sync.Once
+	closeOnce   sync.Once
+
+	notify func(key K, kind EventKind) // optional, wired up by ShardedLRU
 }
 
-func NewLruCLient(cap int) *LRU {
-	lru := &LRU{
-		right: &Node{},
-		left:  &Node{},
+// Option configures a Cache at construction time, e.g. WithPolicy.
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithPolicy selects the eviction strategy. Defaults to PolicyLRU.
+func WithPolicy[K comparable, V any](p PolicyType) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.policyType = p
+	}
+}
+
+// NewCache builds a cache whose eviction strategy is swappable via
+// options, e.g. NewCache[string, []byte](cap, WithPolicy(PolicyLFU)).
+func NewCache[K comparable, V any](cap int, opts ...Option[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{
+		right: &Node[K, V]{},
+		left:  &Node[K, V]{},
 		cap:   cap,
-		cache: make(map[int]*Node),
+		cache: make(map[K]*Node[K, V]),
 	}
-	lru.right.prev = lru.left
-	lru.left.next = lru.right
-	return lru
+	c.right.prev = c.left
+	c.left.next = c.right
+
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.policy = newPolicy[K, V](c.policyType, c)
+	c.janitorDone = make(chan struct{})
+
+	return c
 }
 
-func (lru *LRU) Put(key, value int) bool {
-	lru.my.Lock()
-	defer lru.my.Unlock()
+// Put inserts or updates a key-value pair, evicting via the active
+// Policy if the cache is now over capacity.
+func (c *Cache[K, V]) Put(key K, value V) bool {
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	return c.putLocked(key, value)
+}
 
-	if n, exists := lru.cache[key]; exists {
+// putLocked contains the actual insert/update/evict logic; callers must
+// already hold c.my for writing. Split out so PutWithTTL can insert the
+// value and set its expiry under a single critical section.
+func (c *Cache[K, V]) putLocked(key K, value V) bool {
+	if n, exists := c.cache[key]; exists {
 		fmt.Printf("Already exists, updating %v with %v \n", key, value)
-		lru.moveNodeToHead(n)
+		c.moveNodeToHead(n)
 		n.val = value
+		c.clearExpiryLocked(n)
+		c.policy.OnPut(key)
 		return true
 	}
 
 	fmt.Printf("Creating new key %v with %v \n", key, value)
-	newNode := &Node{key: key, val: value}
-	lru.addNode(newNode)
-	lru.cache[key] = newNode
-
-	if len(lru.cache) > lru.cap {
-		lruNode := lru.left.next
-		fmt.Printf("Deleting LRU as max cap reached %v \n", lruNode.key)
-		delete(lru.cache, lruNode.key)
-		removeNode(lruNode)
+	newNode := &Node[K, V]{key: key, val: value, heapIndex: -1}
+	c.addNode(newNode)
+	c.cache[key] = newNode
+	c.policy.OnPut(key)
+
+	if len(c.cache) > c.cap {
+		if evictKey, ok := c.policy.Evict(); ok {
+			fmt.Printf("Deleting %v as max cap reached %v \n", c.policyType, evictKey)
+			c.removeLocked(c.cache[evictKey], EventEvict)
+		} else {
+			// Nothing eligible to evict under this policy (e.g.
+			// volatile-ttl with no key carrying a TTL yet) - refuse the
+			// eviction rather than index a miss into a pointer deref,
+			// same as Redis's maxmemory-policy behaving like noeviction
+			// when its key set is empty.
+			fmt.Printf("Policy %v has nothing to evict, over capacity \n", c.policyType)
+		}
 	}
 
 	return true
 }
 
-func (lru *LRU) Get(key int) int {
-	lru.my.RLock()
-	node, exists := lru.cache[key]
-	lru.my.RUnlock()
+// Get returns the value for key and whether it was present (and not
+// expired).
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.my.RLock()
+	_, exists := c.cache[key]
+	c.my.RUnlock()
 	if !exists {
-		return -1
+		var zero V
+		return zero, false
+	}
+
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	// Re-fetch under the write lock: the RLock above was already dropped,
+	// so another goroutine's Put may have evicted key in the gap. Using
+	// the stale node would splice an entry with no matching c.cache[key]
+	// back into the DLL, which a later Evict() can then hand back to
+	// removeLocked as a dangling key.
+	node, exists := c.cache[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	if c.expiredLocked(node) {
+		c.removeLocked(node, EventExpire)
+		var zero V
+		return zero, false
+	}
+
+	c.moveNodeToHead(node)
+	c.policy.OnGet(key)
+
+	return node.val, true
+}
+
+// Delete removes key, reporting whether it was present.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	node, exists := c.cache[key]
+	if !exists {
+		return false
+	}
+	c.removeLocked(node, EventDelete)
+	return true
+}
+
+// Len reports the number of entries currently cached.
+func (c *Cache[K, V]) Len() int {
+	c.my.RLock()
+	defer c.my.RUnlock()
+
+	return len(c.cache)
+}
+
+// Keys returns every cached, non-expired key, ordered from least to most
+// recently used.
+func (c *Cache[K, V]) Keys() []K {
+	c.my.RLock()
+	defer c.my.RUnlock()
+
+	keys := make([]K, 0, len(c.cache))
+	for n := c.left.next; n != c.right; n = n.next {
+		if c.expiredLocked(n) {
+			continue
+		}
+		keys = append(keys, n.key)
 	}
+	return keys
+}
+
+// Range iterates the non-expired entries in LRU order, stopping early if
+// fn returns false.
+func (c *Cache[K, V]) Range(fn func(K, V) bool) {
+	c.my.RLock()
+	defer c.my.RUnlock()
 
-	// Now we need to reorder -> exclusive lock
-	lru.my.Lock()
-	lru.moveNodeToHead(node)
-	lru.my.Unlock()
+	for n := c.left.next; n != c.right; n = n.next {
+		if c.expiredLocked(n) {
+			continue
+		}
+		if !fn(n.key, n.val) {
+			return
+		}
+	}
+}
 
-	return node.val
+// removeLocked drops a node from the map, the ordering list, the
+// policy's own bookkeeping and the expiry heap. Callers must already
+// hold c.my for writing.
+func (c *Cache[K, V]) removeLocked(n *Node[K, V], kind EventKind) {
+	// OnDelete runs first: policies like lfuPolicy look up c.cache[key] to
+	// read metadata (e.g. freq) off the Node, so the map entry must still
+	// be there when the policy is notified.
+	c.policy.OnDelete(n.key)
+	delete(c.cache, n.key)
+	removeNode(n)
+	if n.heapIndex >= 0 {
+		heap.Remove(&c.expiry, n.heapIndex)
+	}
+	if c.notify != nil {
+		c.notify(n.key, kind)
+	}
 }
 
-func removeNode(n *Node) {
+func removeNode[K comparable, V any](n *Node[K, V]) {
 	n.prev.next = n.next
 	n.next.prev = n.prev
 }
 
 // head is left, tail is right
-func (lru *LRU) addNode(n *Node) {
-	currRight := lru.right
-	currPrev := lru.right.prev
+func (c *Cache[K, V]) addNode(n *Node[K, V]) {
+	currRight := c.right
+	currPrev := c.right.prev
 
 	currPrev.next = n
 	currRight.prev = n
@@ -91,9 +241,30 @@ func (lru *LRU) addNode(n *Node) {
 	n.next = currRight
 }
 
-func (lru *LRU) moveNodeToHead(node *Node) {
+func (c *Cache[K, V]) moveNodeToHead(node *Node[K, V]) {
 	removeNode(node)
-	lru.addNode(node)
+	c.addNode(node)
+}
+
+// LRU is a backward-compatible int-keyed, int-valued cache. It preserves
+// the pre-generics API - Get returns -1 on a miss instead of (V, bool) -
+// for callers (and the Run demo below) written before Cache was generic.
+type LRU struct {
+	*Cache[int, int]
+}
+
+// NewLruCLient builds an int-keyed LRU using the default (allkeys-lru) policy.
+func NewLruCLient(cap int) *LRU {
+	return &LRU{Cache: NewCache[int, int](cap)}
+}
+
+// Get returns -1 if key is absent or expired, matching the original sentinel API.
+func (lru *LRU) Get(key int) int {
+	val, ok := lru.Cache.Get(key)
+	if !ok {
+		return -1
+	}
+	return val
 }
 
 func Run() {
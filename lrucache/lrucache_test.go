@@ -0,0 +1,78 @@
+package lrucache
+
+import "testing"
+
+func TestCacheGenericStringKeyValue(t *testing.T) {
+	c := NewCache[string, []byte](2)
+
+	c.Put("a", []byte("hello"))
+	if v, ok := c.Get("a"); !ok || string(v) != "hello" {
+		t.Fatalf("Get(a) = %v, %v; want hello, true", v, ok)
+	}
+}
+
+func TestCacheDeleteRemovesKey(t *testing.T) {
+	c := NewCache[int, int](2)
+
+	c.Put(1, 100)
+	if !c.Delete(1) {
+		t.Fatalf("Delete(1) = false; want true for a present key")
+	}
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("Get(1) should miss after Delete")
+	}
+	if c.Delete(1) {
+		t.Fatalf("Delete(1) = true; want false on a key that's already gone")
+	}
+}
+
+func TestCacheKeysAndRangeReflectLRUOrder(t *testing.T) {
+	c := NewCache[int, int](3)
+
+	c.Put(1, 100)
+	c.Put(2, 200)
+	c.Put(3, 300)
+	c.Get(1) // moves 1 to MRU, leaving order 2, 3, 1
+
+	want := []int{2, 3, 1}
+	if keys := c.Keys(); !equalKeys(keys, want) {
+		t.Fatalf("Keys() = %v; want %v", keys, want)
+	}
+
+	var ranged []int
+	c.Range(func(k, v int) bool {
+		ranged = append(ranged, k)
+		return true
+	})
+	if !equalKeys(ranged, want) {
+		t.Fatalf("Range() visited %v; want %v", ranged, want)
+	}
+}
+
+func TestCacheRangeStopsEarly(t *testing.T) {
+	c := NewCache[int, int](3)
+	c.Put(1, 100)
+	c.Put(2, 200)
+	c.Put(3, 300)
+
+	var visited int
+	c.Range(func(k, v int) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Fatalf("Range() visited %d entries; want 1 after returning false immediately", visited)
+	}
+}
+
+func equalKeys(got, want []int) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
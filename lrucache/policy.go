@@ -0,0 +1,294 @@
+package lrucache
+
+import "math/rand"
+
+// PolicyType selects which eviction strategy a Cache uses, mirroring
+// Redis's maxmemory-policy modes.
+type PolicyType int
+
+const (
+	PolicyLRU PolicyType = iota
+	PolicyLFU
+	PolicyRandom
+	PolicyVolatileTTL
+)
+
+// Policy decides which key to evict once a cache is over capacity. The
+// core cache notifies a Policy of every touch so it can track whatever
+// ordering it needs (recency, frequency, TTL, ...) without the map/list
+// code in lrucache.go having to know which strategy is active.
+type Policy[K comparable, V any] interface {
+	OnGet(key K)
+	OnPut(key K)
+	OnDelete(key K)
+	// Evict reports the key to evict next, or ok=false if the policy has
+	// nothing eligible (e.g. volatile-ttl with no key carrying a TTL).
+	Evict() (key K, ok bool)
+}
+
+func newPolicy[K comparable, V any](t PolicyType, c *Cache[K, V]) Policy[K, V] {
+	switch t {
+	case PolicyLFU:
+		return newLFUPolicy[K, V](c)
+	case PolicyRandom:
+		return newRandomPolicy[K]()
+	case PolicyVolatileTTL:
+		return newVolatileTTLPolicy[K, V](c)
+	default:
+		return newLRUPolicy[K, V](c)
+	}
+}
+
+// ----------------------------
+// allkeys-lru
+// ----------------------------
+
+// lruPolicy just reads the ordering the core cache already maintains in
+// its own doubly linked list, so OnGet/OnPut are no-ops.
+type lruPolicy[K comparable, V any] struct {
+	c *Cache[K, V]
+}
+
+func newLRUPolicy[K comparable, V any](c *Cache[K, V]) *lruPolicy[K, V] {
+	return &lruPolicy[K, V]{c: c}
+}
+
+func (p *lruPolicy[K, V]) OnGet(key K)    {}
+func (p *lruPolicy[K, V]) OnPut(key K)    {}
+func (p *lruPolicy[K, V]) OnDelete(key K) {}
+
+func (p *lruPolicy[K, V]) Evict() (K, bool) {
+	if p.c.left.next == p.c.right {
+		var zero K
+		return zero, false
+	}
+	return p.c.left.next.key, true
+}
+
+// ----------------------------
+// allkeys-lfu
+// ----------------------------
+
+// freqNode is a key sitting inside one frequency bucket.
+type freqNode[K comparable] struct {
+	prev *freqNode[K]
+	next *freqNode[K]
+	key  K
+}
+
+// freqBucket is a small doubly linked list of all keys currently at one
+// frequency count, ordered by recency so ties break LRU-style.
+type freqBucket[K comparable] struct {
+	left  *freqNode[K]
+	right *freqNode[K]
+	size  int
+}
+
+func newFreqBucket[K comparable]() *freqBucket[K] {
+	b := &freqBucket[K]{left: &freqNode[K]{}, right: &freqNode[K]{}}
+	b.right.prev = b.left
+	b.left.next = b.right
+	return b
+}
+
+func (b *freqBucket[K]) pushMRU(n *freqNode[K]) {
+	currRight := b.right
+	currPrev := b.right.prev
+
+	currPrev.next = n
+	currRight.prev = n
+
+	n.prev = currPrev
+	n.next = currRight
+	b.size++
+}
+
+func (b *freqBucket[K]) remove(n *freqNode[K]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	b.size--
+}
+
+func (b *freqBucket[K]) popLRU() *freqNode[K] {
+	n := b.left.next
+	b.remove(n)
+	return n
+}
+
+// lfuPolicy implements allkeys-lfu with a hash of freq -> bucket of keys,
+// plus a pointer to the current minimum frequency so Evict finds its
+// victim without a scan. When the minimum bucket empties (on Evict or
+// OnDelete), the new minimum is found by scanning the live buckets in
+// lowestBucket, so those steps are not O(1).
+type lfuPolicy[K comparable, V any] struct {
+	c       *Cache[K, V]
+	buckets map[int]*freqBucket[K]
+	nodes   map[K]*freqNode[K]
+	minFreq int
+}
+
+func newLFUPolicy[K comparable, V any](c *Cache[K, V]) *lfuPolicy[K, V] {
+	return &lfuPolicy[K, V]{
+		c:       c,
+		buckets: make(map[int]*freqBucket[K]),
+		nodes:   make(map[K]*freqNode[K]),
+	}
+}
+
+// removeFromBucket drops fn from the freq bucket it lives in, clearing
+// the bucket once empty and re-deriving minFreq if that was the bucket
+// holding it.
+func (p *lfuPolicy[K, V]) removeFromBucket(freq int, fn *freqNode[K]) {
+	b, exists := p.buckets[freq]
+	if !exists {
+		return
+	}
+	b.remove(fn)
+	if b.size == 0 {
+		delete(p.buckets, freq)
+		if p.minFreq == freq {
+			p.minFreq = p.lowestBucket()
+		}
+	}
+}
+
+// lowestBucket scans the (small, freq-count-bounded) set of live buckets
+// for the new minimum, or 0 ("unset") if none remain.
+func (p *lfuPolicy[K, V]) lowestBucket() int {
+	min := 0
+	for freq := range p.buckets {
+		if min == 0 || freq < min {
+			min = freq
+		}
+	}
+	return min
+}
+
+// touch bumps key's frequency by one, moving it into the next bucket.
+func (p *lfuPolicy[K, V]) touch(key K) {
+	n, exists := p.c.cache[key]
+	if !exists {
+		return
+	}
+
+	oldFreq := n.freq
+	if fn, tracked := p.nodes[key]; tracked {
+		p.removeFromBucket(oldFreq, fn)
+	}
+
+	n.freq = oldFreq + 1
+	if _, exists := p.buckets[n.freq]; !exists {
+		p.buckets[n.freq] = newFreqBucket[K]()
+	}
+	fn := &freqNode[K]{key: key}
+	p.buckets[n.freq].pushMRU(fn)
+	p.nodes[key] = fn
+
+	if p.minFreq == 0 || n.freq < p.minFreq {
+		p.minFreq = n.freq
+	}
+}
+
+func (p *lfuPolicy[K, V]) OnGet(key K) { p.touch(key) }
+func (p *lfuPolicy[K, V]) OnPut(key K) { p.touch(key) }
+
+func (p *lfuPolicy[K, V]) OnDelete(key K) {
+	fn, tracked := p.nodes[key]
+	if !tracked {
+		return
+	}
+	// The Node is still in p.c.cache here: removeLocked notifies the
+	// policy before deleting its map entry, precisely so this read is safe.
+	p.removeFromBucket(p.c.cache[key].freq, fn)
+	delete(p.nodes, key)
+}
+
+func (p *lfuPolicy[K, V]) Evict() (K, bool) {
+	bucket, exists := p.buckets[p.minFreq]
+	if !exists {
+		var zero K
+		return zero, false
+	}
+	fn := bucket.popLRU()
+	delete(p.nodes, fn.key)
+	if bucket.size == 0 {
+		delete(p.buckets, p.minFreq)
+		p.minFreq = p.lowestBucket()
+	}
+	return fn.key, true
+}
+
+// ----------------------------
+// allkeys-random
+// ----------------------------
+
+// randomPolicy keeps every key in a slice with an index map alongside,
+// so both insertion and eviction are O(1) via swap-remove.
+type randomPolicy[K comparable] struct {
+	keys    []K
+	indices map[K]int
+}
+
+func newRandomPolicy[K comparable]() *randomPolicy[K] {
+	return &randomPolicy[K]{indices: make(map[K]int)}
+}
+
+func (p *randomPolicy[K]) OnGet(key K) {}
+
+func (p *randomPolicy[K]) OnPut(key K) {
+	if _, exists := p.indices[key]; exists {
+		return
+	}
+	p.indices[key] = len(p.keys)
+	p.keys = append(p.keys, key)
+}
+
+func (p *randomPolicy[K]) OnDelete(key K) {
+	idx, exists := p.indices[key]
+	if !exists {
+		return
+	}
+	last := len(p.keys) - 1
+	p.keys[idx] = p.keys[last]
+	p.indices[p.keys[idx]] = idx
+	p.keys = p.keys[:last]
+	delete(p.indices, key)
+}
+
+func (p *randomPolicy[K]) Evict() (K, bool) {
+	if len(p.keys) == 0 {
+		var zero K
+		return zero, false
+	}
+	return p.keys[rand.Intn(len(p.keys))], true
+}
+
+// ----------------------------
+// volatile-ttl
+// ----------------------------
+
+// volatileTTLPolicy picks the key with the soonest expiration, mirroring
+// Redis's volatile-ttl maxmemory-policy. It leans entirely on the expiry
+// min-heap the core cache already maintains for the janitor.
+type volatileTTLPolicy[K comparable, V any] struct {
+	c *Cache[K, V]
+}
+
+func newVolatileTTLPolicy[K comparable, V any](c *Cache[K, V]) *volatileTTLPolicy[K, V] {
+	return &volatileTTLPolicy[K, V]{c: c}
+}
+
+func (p *volatileTTLPolicy[K, V]) OnGet(key K)    {}
+func (p *volatileTTLPolicy[K, V]) OnPut(key K)    {}
+func (p *volatileTTLPolicy[K, V]) OnDelete(key K) {}
+
+// Evict reports ok=false when no key currently carries a TTL, the same
+// way Redis's volatile-ttl policy refuses to evict when there are no
+// volatile keys rather than falling back to evicting something else.
+func (p *volatileTTLPolicy[K, V]) Evict() (K, bool) {
+	if p.c.expiry.Len() == 0 {
+		var zero K
+		return zero, false
+	}
+	return p.c.expiry[0].key, true
+}
@@ -0,0 +1,67 @@
+package lrucache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	c := NewCache[int, int](2, WithPolicy[int, int](PolicyLFU))
+
+	c.Put(1, 100)
+	c.Put(2, 200)
+	c.Get(1) // bump 1's freq so 2 becomes the least frequently used
+
+	c.Put(3, 300) // over capacity: should evict 2, not 1
+
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("Get(2) should have been evicted as least frequently used")
+	}
+	if v, ok := c.Get(1); !ok || v != 100 {
+		t.Fatalf("Get(1) = %v, %v; want 100, true", v, ok)
+	}
+}
+
+func TestLFUDeleteThenEvictDoesNotPanic(t *testing.T) {
+	c := NewCache[int, int](2, WithPolicy[int, int](PolicyLFU))
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Delete(1)
+
+	c.Put(3, 3)
+	c.Put(4, 4) // drives eviction from minFreq's bucket after Delete removed key 1 from it
+
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d; want 2", c.Len())
+	}
+}
+
+func TestRandomPolicyEvictsDownToCapacity(t *testing.T) {
+	c := NewCache[int, int](2, WithPolicy[int, int](PolicyRandom))
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d; want 2", c.Len())
+	}
+}
+
+func TestVolatileTTLEvictsSoonestExpiry(t *testing.T) {
+	c := NewCache[int, int](2, WithPolicy[int, int](PolicyVolatileTTL))
+	defer c.Close()
+
+	c.PutWithTTL(1, 100, time.Hour)
+	c.PutWithTTL(2, 200, time.Minute)
+
+	c.PutWithTTL(3, 300, time.Hour) // over capacity: key 2 expires soonest
+
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("Get(2) should have been evicted as the soonest to expire")
+	}
+	if v, ok := c.Get(1); !ok || v != 100 {
+		t.Fatalf("Get(1) = %v, %v; want 100, true", v, ok)
+	}
+}
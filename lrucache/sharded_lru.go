@@ -0,0 +1,146 @@
+package lrucache
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// EventKind identifies why a ShardedLRU emitted an invalidation Event.
+type EventKind int
+
+const (
+	EventPut EventKind = iota
+	EventEvict
+	EventDelete
+	EventExpire
+)
+
+// Event is published to subscribers whenever a key is put, evicted,
+// deleted or expires, mirroring how an in-memory cache gets invalidated
+// off of database NOTIFY events: external processes can react without
+// polling.
+type Event[K comparable] struct {
+	Key  K
+	Kind EventKind
+}
+
+// subscriberBuffer bounds how far a slow subscriber can lag before
+// ShardedLRU starts dropping its events rather than blocking writers.
+const subscriberBuffer = 64
+
+// ShardMetrics reports hit/miss counters for one shard.
+type ShardMetrics struct {
+	Hits   int64
+	Misses int64
+}
+
+// ShardedLRU wraps N independent Cache shards, chosen by fnv(key) % N,
+// each with its own lock. This removes the single-lock contention a
+// plain Cache has on Get, which takes a write lock just to reorder the
+// list.
+type ShardedLRU[K comparable, V any] struct {
+	shards []*Cache[K, V]
+	hits   []int64
+	misses []int64
+
+	subsMu sync.RWMutex
+	subs   []chan Event[K]
+}
+
+// NewShardedLRU splits cap evenly across n shards.
+func NewShardedLRU[K comparable, V any](cap, n int) *ShardedLRU[K, V] {
+	if n < 1 {
+		n = 1
+	}
+	perShard := cap / n
+	if perShard < 1 {
+		perShard = 1
+	}
+
+	s := &ShardedLRU[K, V]{
+		shards: make([]*Cache[K, V], n),
+		hits:   make([]int64, n),
+		misses: make([]int64, n),
+	}
+	for i := range s.shards {
+		shard := NewCache[K, V](perShard)
+		shard.notify = s.publish
+		s.shards[i] = shard
+	}
+	return s
+}
+
+func (s *ShardedLRU[K, V]) shardFor(key K) int {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%v", key)
+	return int(h.Sum32() % uint32(len(s.shards)))
+}
+
+// Get retrieves value for key from its shard, tracking a hit/miss.
+func (s *ShardedLRU[K, V]) Get(key K) (V, bool) {
+	idx := s.shardFor(key)
+	val, ok := s.shards[idx].Get(key)
+	if ok {
+		atomic.AddInt64(&s.hits[idx], 1)
+	} else {
+		atomic.AddInt64(&s.misses[idx], 1)
+	}
+	return val, ok
+}
+
+// Put inserts or updates key in its shard and publishes an Event.
+func (s *ShardedLRU[K, V]) Put(key K, value V) bool {
+	idx := s.shardFor(key)
+	ok := s.shards[idx].Put(key, value)
+	s.publish(key, EventPut)
+	return ok
+}
+
+// Close stops every shard's janitor goroutine.
+func (s *ShardedLRU[K, V]) Close() {
+	for _, shard := range s.shards {
+		shard.Close()
+	}
+}
+
+// Subscribe returns a channel of invalidation events. Events are
+// dispatched non-blockingly: a subscriber that falls behind has events
+// dropped rather than stalling cache writes.
+func (s *ShardedLRU[K, V]) Subscribe() <-chan Event[K] {
+	ch := make(chan Event[K], subscriberBuffer)
+
+	s.subsMu.Lock()
+	s.subs = append(s.subs, ch)
+	s.subsMu.Unlock()
+
+	return ch
+}
+
+func (s *ShardedLRU[K, V]) publish(key K, kind EventKind) {
+	event := Event[K]{Key: key, Kind: kind}
+
+	s.subsMu.RLock()
+	defer s.subsMu.RUnlock()
+
+	for _, ch := range s.subs {
+		select {
+		case ch <- event:
+		default:
+			// slow subscriber - drop rather than block the writer
+		}
+	}
+}
+
+// Metrics reports hit/miss counters per shard, for pressure observability.
+func (s *ShardedLRU[K, V]) Metrics() []ShardMetrics {
+	metrics := make([]ShardMetrics, len(s.shards))
+	for i := range s.shards {
+		metrics[i] = ShardMetrics{
+			Hits:   atomic.LoadInt64(&s.hits[i]),
+			Misses: atomic.LoadInt64(&s.misses[i]),
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,52 @@
+package lrucache
+
+import "testing"
+
+func TestShardedLRUPutGetRoundTrip(t *testing.T) {
+	s := NewShardedLRU[int, int](8, 4)
+	defer s.Close()
+
+	for i := 0; i < 8; i++ {
+		s.Put(i, i*10)
+	}
+	for i := 0; i < 8; i++ {
+		if v, ok := s.Get(i); !ok || v != i*10 {
+			t.Fatalf("Get(%d) = %v, %v; want %v, true", i, v, ok, i*10)
+		}
+	}
+}
+
+func TestShardedLRUMetricsTracksHitsAndMisses(t *testing.T) {
+	s := NewShardedLRU[int, int](8, 2)
+	defer s.Close()
+
+	s.Put(1, 100)
+	s.Get(1)         // hit
+	s.Get(999999999) // miss
+
+	var hits, misses int64
+	for _, m := range s.Metrics() {
+		hits += m.Hits
+		misses += m.Misses
+	}
+	if hits != 1 || misses != 1 {
+		t.Fatalf("Metrics() totals = hits=%d misses=%d; want hits=1 misses=1", hits, misses)
+	}
+}
+
+func TestShardedLRUSubscribePublishesPut(t *testing.T) {
+	s := NewShardedLRU[int, int](8, 2)
+	defer s.Close()
+
+	ch := s.Subscribe()
+	s.Put(1, 100)
+
+	select {
+	case ev := <-ch:
+		if ev.Key != 1 || ev.Kind != EventPut {
+			t.Fatalf("event = %+v; want Key=1, Kind=EventPut", ev)
+		}
+	default:
+		t.Fatalf("expected a Put event on the subscriber channel")
+	}
+}
@@ -0,0 +1,176 @@
+package lrucache
+
+import (
+	"container/heap"
+	"time"
+)
+
+// defaultJanitorInterval bounds how long an expired key can outlive its
+// TTL when nobody Gets it: the janitor wakes at least this often, even if
+// the expiry heap is empty.
+const defaultJanitorInterval = time.Second
+
+// expiryHeap is a min-heap of *Node ordered by expireAt, letting the
+// janitor (and the volatile-ttl policy) find the next key to expire.
+type expiryHeap[K comparable, V any] []*Node[K, V]
+
+func (h expiryHeap[K, V]) Len() int { return len(h) }
+
+func (h expiryHeap[K, V]) Less(i, j int) bool { return h[i].expireAt.Before(h[j].expireAt) }
+
+func (h expiryHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *expiryHeap[K, V]) Push(x any) {
+	n := x.(*Node[K, V])
+	n.heapIndex = len(*h)
+	*h = append(*h, n)
+}
+
+func (h *expiryHeap[K, V]) Pop() any {
+	old := *h
+	last := len(old) - 1
+	n := old[last]
+	old[last] = nil
+	n.heapIndex = -1
+	*h = old[:last]
+	return n
+}
+
+// PutWithTTL inserts or updates key, then sets it to expire after ttl.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	c.startJanitor()
+
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	ok := c.putLocked(key, value)
+	c.setExpiryLocked(key, ttl)
+	return ok
+}
+
+// Expire sets or refreshes the TTL on an existing key. Returns false if
+// the key is absent (or already expired).
+func (c *Cache[K, V]) Expire(key K, ttl time.Duration) bool {
+	c.startJanitor()
+
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	node, exists := c.cache[key]
+	if !exists || c.expiredLocked(node) {
+		return false
+	}
+	c.setExpiryLocked(key, ttl)
+	return true
+}
+
+// startJanitor launches the background sweep goroutine the first time
+// TTL is actually used. A plain Cache that never touches TTL (such as a
+// ShardedLRU shard, which has no TTL API) never pays for a goroutine
+// it has no use for; Close is still safe to call either way.
+func (c *Cache[K, V]) startJanitor() {
+	c.janitorOnce.Do(func() {
+		go c.runJanitor(defaultJanitorInterval)
+	})
+}
+
+// TTL reports the remaining time-to-live on key. A key with no TTL, or
+// that doesn't exist, reports 0.
+func (c *Cache[K, V]) TTL(key K) time.Duration {
+	c.my.RLock()
+	defer c.my.RUnlock()
+
+	node, exists := c.cache[key]
+	if !exists || node.expireAt.IsZero() {
+		return 0
+	}
+	if remaining := time.Until(node.expireAt); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Close stops the background janitor goroutine. Safe to call more than
+// once; safe to skip entirely if the cache is just garbage collected.
+func (c *Cache[K, V]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.janitorDone)
+	})
+}
+
+// setExpiryLocked stamps key's expireAt and (re)places it in the expiry
+// heap. Callers must already hold c.my for writing.
+func (c *Cache[K, V]) setExpiryLocked(key K, ttl time.Duration) {
+	node, exists := c.cache[key]
+	if !exists {
+		return
+	}
+
+	node.expireAt = time.Now().Add(ttl)
+	if node.heapIndex >= 0 {
+		heap.Fix(&c.expiry, node.heapIndex)
+		return
+	}
+	heap.Push(&c.expiry, node)
+}
+
+// clearExpiryLocked drops any TTL on node, removing it from the expiry
+// heap if it was tracked there. A plain Put that overwrites an existing
+// key clears its TTL, the same as Redis's SET does unless KEEPTTL is
+// requested; PutWithTTL calls this and then setExpiryLocked to install
+// the new one. Callers must already hold c.my for writing.
+func (c *Cache[K, V]) clearExpiryLocked(node *Node[K, V]) {
+	if node.heapIndex >= 0 {
+		heap.Remove(&c.expiry, node.heapIndex)
+	}
+	node.expireAt = time.Time{}
+}
+
+// expiredLocked reports whether node has a TTL that has already passed.
+func (c *Cache[K, V]) expiredLocked(node *Node[K, V]) bool {
+	return !node.expireAt.IsZero() && time.Now().After(node.expireAt)
+}
+
+// runJanitor wakes up either at `interval` or at the next heap-top
+// expiry, whichever is sooner, and sweeps every expired key under the
+// write lock - the same active-expiration cycle Redis runs.
+func (c *Cache[K, V]) runJanitor(interval time.Duration) {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.janitorDone:
+			return
+		case <-timer.C:
+			c.sweepExpired()
+		}
+
+		next := interval
+		c.my.RLock()
+		if c.expiry.Len() > 0 {
+			if untilNext := time.Until(c.expiry[0].expireAt); untilNext < next {
+				next = untilNext
+			}
+		}
+		c.my.RUnlock()
+		if next < 0 {
+			next = 0
+		}
+		timer.Reset(next)
+	}
+}
+
+// sweepExpired evicts every key whose TTL has already passed.
+func (c *Cache[K, V]) sweepExpired() {
+	c.my.Lock()
+	defer c.my.Unlock()
+
+	for c.expiry.Len() > 0 && c.expiredLocked(c.expiry[0]) {
+		c.removeLocked(c.expiry[0], EventExpire)
+	}
+}
@@ -0,0 +1,60 @@
+package lrucache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPutWithTTLExpires(t *testing.T) {
+	c := NewCache[int, int](2)
+	defer c.Close()
+
+	c.PutWithTTL(1, 100, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("Get(1) should report a miss once its TTL has passed")
+	}
+}
+
+func TestExpireRefreshesTTL(t *testing.T) {
+	c := NewCache[int, int](2)
+	defer c.Close()
+
+	c.PutWithTTL(1, 100, 10*time.Millisecond)
+	if !c.Expire(1, time.Hour) {
+		t.Fatalf("Expire(1) = false; want true on a live key")
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	if v, ok := c.Get(1); !ok || v != 100 {
+		t.Fatalf("Get(1) = %v, %v; want 100, true after Expire refreshed the TTL", v, ok)
+	}
+}
+
+func TestPutClearsExistingTTL(t *testing.T) {
+	c := NewCache[int, int](2)
+	defer c.Close()
+
+	c.PutWithTTL(1, 100, 10*time.Millisecond)
+	c.Put(1, 200) // plain overwrite should clear the TTL, like Redis SET without KEEPTTL
+	time.Sleep(20 * time.Millisecond)
+
+	if v, ok := c.Get(1); !ok || v != 200 {
+		t.Fatalf("Get(1) = %v, %v; want 200, true - overwrite should have cleared the TTL", v, ok)
+	}
+}
+
+func TestTTLReportsRemainingTime(t *testing.T) {
+	c := NewCache[int, int](2)
+	defer c.Close()
+
+	c.PutWithTTL(1, 100, time.Hour)
+
+	if remaining := c.TTL(1); remaining <= 0 || remaining > time.Hour {
+		t.Fatalf("TTL(1) = %v; want a positive duration at most an hour", remaining)
+	}
+	if remaining := c.TTL(2); remaining != 0 {
+		t.Fatalf("TTL(2) = %v; want 0 for an absent key", remaining)
+	}
+}